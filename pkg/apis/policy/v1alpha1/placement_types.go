@@ -0,0 +1,191 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Placement represents the rule for select clusters.
+type Placement struct {
+	// ReplicaScheduling represents the scheduling policy on dealing with the number of replicas
+	// when propagating resources that have replicas in spec (e.g. deployments, statefulsets) to member clusters.
+	// +optional
+	ReplicaScheduling *ReplicaSchedulingStrategy `json:"replicaScheduling,omitempty"`
+
+	// SpreadConstraints represents a list of the rules used to ensure the propagated
+	// resource evenly spreads across eligible clusters.
+	// +optional
+	SpreadConstraints []SpreadConstraint `json:"spreadConstraints,omitempty"`
+
+	// WorkloadAffinity, if set, keeps resources sharing the same
+	// AffinityGroupLabel apart from each other across member clusters.
+	// +optional
+	WorkloadAffinity *WorkloadAffinityTerm `json:"workloadAffinity,omitempty"`
+
+	// Affinity, if set, co-locates resources sharing the same
+	// AffinityGroupLabel with each other across member clusters. It is
+	// symmetric to WorkloadAffinity but expresses the opposite relation.
+	// +optional
+	Affinity *WorkloadAffinityTerm `json:"affinity,omitempty"`
+}
+
+// ReplicaSchedulingType describes scheduling strategies for replicas.
+type ReplicaSchedulingType string
+
+const (
+	// ReplicaSchedulingTypeDuplicated means that during the scheduling
+	// process, ResourceBinding/ClusterResourceBinding will directly
+	// duplicate the replicas to each candidate member cluster.
+	ReplicaSchedulingTypeDuplicated ReplicaSchedulingType = "Duplicated"
+
+	// ReplicaSchedulingTypeDivided means that during the scheduling process,
+	// ResourceBinding/ClusterResourceBinding will divide replicas into
+	// several parts, and assign each part to a candidate cluster.
+	ReplicaSchedulingTypeDivided ReplicaSchedulingType = "Divided"
+)
+
+// ReplicaSchedulingStrategy represents the replica scheduling strategy.
+type ReplicaSchedulingStrategy struct {
+	// ReplicaSchedulingType determines how the replicas are scheduled when
+	// Karmada propagates a resource that has replicas in spec to member clusters.
+	// +optional
+	ReplicaSchedulingType ReplicaSchedulingType `json:"replicaSchedulingType,omitempty"`
+}
+
+// SpreadConstraint represents the rule for how to spread resources.
+type SpreadConstraint struct {
+	// SpreadByLabel specifies the label to spread by, mutually exclusive with SpreadByField.
+	// +optional
+	SpreadByLabel string `json:"spreadByLabel,omitempty"`
+
+	// SpreadByField specifies the field to spread by, mutually exclusive with SpreadByLabel.
+	// +optional
+	SpreadByField string `json:"spreadByField,omitempty"`
+
+	// MaxGroups restricts the maximum number of cluster groups to be selected.
+	// +optional
+	MaxGroups int `json:"maxGroups,omitempty"`
+
+	// MinGroups restricts the minimum number of cluster groups to be selected.
+	// +optional
+	MinGroups int `json:"minGroups,omitempty"`
+}
+
+// AffinityTermMode determines whether a WorkloadAffinityTerm is enforced as
+// a hard constraint or merely used to bias scoring.
+type AffinityTermMode string
+
+const (
+	// RequiredDuringScheduling means the term is enforced by the owning
+	// FilterPlugin: a violating cluster is hard-rejected. For a
+	// WorkloadAffinity term with a TopologyKey, WhenUnsatisfiable decides
+	// this instead of Mode.
+	RequiredDuringScheduling AffinityTermMode = "RequiredDuringScheduling"
+	// PreferredDuringScheduling means the term is only enforced by the
+	// owning ScorePlugin: a violating cluster is penalized, not rejected. For
+	// a WorkloadAffinity term with a TopologyKey, WhenUnsatisfiable decides
+	// this instead of Mode.
+	PreferredDuringScheduling AffinityTermMode = "PreferredDuringScheduling"
+)
+
+// WorkloadAffinityTerm describes a relation between a resource and its peers
+// sharing the same AffinityGroupLabel value. Depending on which Placement
+// field it's set under (WorkloadAffinity or Affinity), it's interpreted as a
+// negative (keep apart) or positive (co-locate) relation.
+type WorkloadAffinityTerm struct {
+	// AffinityLabelKey is the key looked up on the resource's
+	// AffinityGroupLabel to find the group this term applies to.
+	AffinityLabelKey string `json:"affinityLabelKey"`
+
+	// Mode determines whether this term is a hard requirement or a soft
+	// preference. Defaults to RequiredDuringScheduling.
+	// +optional
+	Mode AffinityTermMode `json:"mode,omitempty"`
+
+	// Weight biases the ScorePlugin's penalty/reward per co-located peer
+	// when Mode is PreferredDuringScheduling, or, for a WorkloadAffinity term
+	// with a TopologyKey, when WhenUnsatisfiable is ScheduleAnyway. Valid
+	// range is 0-100.
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+
+	// TopologyKey, if set, scopes this term to a topology domain: peers are
+	// considered co-located when they share the same value of this label on
+	// their candidate cluster (e.g. a region or zone label), rather than only
+	// when they land on the exact same cluster. TopologyKey, MaxSkew and
+	// WhenUnsatisfiable are only honoured on the WorkloadAffinity term; the
+	// Affinity term always matches peers on the exact same cluster.
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+
+	// MaxSkew describes the degree to which peers sharing this term's
+	// AffinityGroupLabel value may be unevenly spread across TopologyKey's
+	// domains, mirroring pod topology spread constraints. Defaults to 1.
+	// Only meaningful when TopologyKey is set.
+	// +optional
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+
+	// WhenUnsatisfiable determines what happens to a candidate cluster that
+	// would push the topology domain skew above MaxSkew. Defaults to
+	// DoNotSchedule. Only meaningful when TopologyKey is set.
+	// +optional
+	WhenUnsatisfiable UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// UnsatisfiableConstraintAction determines how a FilterPlugin handles a
+// candidate cluster that violates a topology-scoped WorkloadAffinityTerm.
+type UnsatisfiableConstraintAction string
+
+const (
+	// DoNotSchedule instructs the scheduler to hard-reject a candidate
+	// cluster that would violate MaxSkew, via the owning FilterPlugin.
+	DoNotSchedule UnsatisfiableConstraintAction = "DoNotSchedule"
+	// ScheduleAnyway instructs the scheduler not to reject a candidate
+	// cluster over MaxSkew; the ScorePlugin penalizes it instead.
+	ScheduleAnyway UnsatisfiableConstraintAction = "ScheduleAnyway"
+)
+
+// DeepCopy creates a deep copy of Placement.
+func (in *Placement) DeepCopy() *Placement {
+	if in == nil {
+		return nil
+	}
+	out := new(Placement)
+	*out = *in
+	if in.ReplicaScheduling != nil {
+		out.ReplicaScheduling = new(ReplicaSchedulingStrategy)
+		*out.ReplicaScheduling = *in.ReplicaScheduling
+	}
+	if in.SpreadConstraints != nil {
+		out.SpreadConstraints = make([]SpreadConstraint, len(in.SpreadConstraints))
+		copy(out.SpreadConstraints, in.SpreadConstraints)
+	}
+	if in.WorkloadAffinity != nil {
+		out.WorkloadAffinity = in.WorkloadAffinity.DeepCopy()
+	}
+	if in.Affinity != nil {
+		out.Affinity = in.Affinity.DeepCopy()
+	}
+	return out
+}
+
+// DeepCopy creates a deep copy of WorkloadAffinityTerm.
+func (in *WorkloadAffinityTerm) DeepCopy() *WorkloadAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadAffinityTerm)
+	*out = *in
+	return out
+}
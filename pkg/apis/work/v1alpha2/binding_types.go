@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+)
+
+// EvictionReason explains why a target cluster was evicted from a binding's
+// Clusters, triggering a failover.
+const (
+	// EvictionReasonApplicationFailure means the eviction was triggered by
+	// the application itself being unhealthy on the cluster.
+	EvictionReasonApplicationFailure = "ApplicationFailure"
+	// EvictionReasonTaintUntolerated means the eviction was triggered by the
+	// cluster being tainted in a way the binding doesn't tolerate.
+	EvictionReasonTaintUntolerated = "TaintUntolerated"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceBinding represents a binding of a kubernetes resource with a propagation policy.
+//
+// +kubebuilder:printcolumn:name="Attempts",type=integer,JSONPath=".status.failoverBackoff.consecutiveFailures",description="Consecutive failover attempts recorded for the binding's current origin cluster."
+// +kubebuilder:printcolumn:name="Next-Eligible",type=date,JSONPath=".status.failoverBackoff.nextEligibleTime",description="Earliest time another failover may be triggered."
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+type ResourceBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of ResourceBinding.
+	Spec ResourceBindingSpec `json:"spec"`
+
+	// Status represents the most recently observed status of the ResourceBinding.
+	// +optional
+	Status ResourceBindingStatus `json:"status,omitempty"`
+}
+
+// ResourceBindingSpec represents the expectation of ResourceBinding.
+type ResourceBindingSpec struct {
+	// Resource represents the Kubernetes resource to be propagated.
+	Resource ObjectReference `json:"resource"`
+
+	// Clusters represents target member clusters where the resource to be deployed.
+	// +optional
+	Clusters []TargetCluster `json:"clusters,omitempty"`
+
+	// Placement represents the rule for target cluster selection.
+	// +optional
+	Placement policyv1alpha1.Placement `json:"placement,omitempty"`
+}
+
+// ObjectReference contains enough information to locate the referenced object inside current cluster.
+type ObjectReference struct {
+	// APIVersion represents the API version of the referent.
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind represents the Kind of the referent.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Namespace represents the namespace of the referent.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name represents the name of the referent.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// AffinityGroupLabel carries the resource's own affinity-related labels,
+	// looked up by WorkloadAffinity/Affinity terms' AffinityLabelKey.
+	// +optional
+	AffinityGroupLabel map[string]string `json:"affinityGroupLabel,omitempty"`
+}
+
+// TargetCluster represents a member cluster to which resources can be deployed.
+type TargetCluster struct {
+	// Name of target cluster.
+	Name string `json:"name"`
+
+	// Replicas represents the replica number to be scheduled to this cluster.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+}
+
+// ResourceBindingStatus represents the overall status of the strategy as well as the referenced resources.
+type ResourceBindingStatus struct {
+	// Conditions contain the different condition statuses.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// AggregatedStatus represents the resource's status collected per target cluster.
+	// +optional
+	AggregatedStatus []AggregatedStatusItem `json:"aggregatedStatus,omitempty"`
+
+	// FailoverHistory records each failover performed for this binding, most recent last.
+	// +optional
+	FailoverHistory []FailoverHistoryItem `json:"failoverHistory,omitempty"`
+
+	// FailoverBackoff records the exponential-backoff window currently in
+	// effect before another failover may be triggered.
+	// +optional
+	FailoverBackoff *FailoverBackoff `json:"failoverBackoff,omitempty"`
+}
+
+// AggregatedStatusItem represents the resource's status on a member cluster.
+type AggregatedStatusItem struct {
+	// ClusterName represents the name of member cluster.
+	ClusterName string `json:"clusterName"`
+
+	// Status reflects the running status of the current resource, as reported by the member cluster.
+	// +optional
+	Status *runtime.RawExtension `json:"status,omitempty"`
+}
+
+// FailoverHistoryItem records a single failover event.
+type FailoverHistoryItem struct {
+	// FailoverTime is the time the failover was recorded.
+	// +optional
+	FailoverTime *metav1.Time `json:"failoverTime,omitempty"`
+
+	// OriginCluster is the cluster the resource was evicted from.
+	OriginCluster string `json:"originCluster"`
+
+	// DestinationCluster is the cluster the resource failed over to, if one
+	// had already been selected at the time the event was recorded.
+	// +optional
+	DestinationCluster string `json:"destinationCluster,omitempty"`
+
+	// Reason is one of the EvictionReason constants explaining why the
+	// failover was triggered.
+	Reason string `json:"reason"`
+
+	// ResourceHealthAtFailover snapshots the resource's health on
+	// OriginCluster at the moment the failover was recorded, for display in
+	// the RB status printer (see utils.ResourceHealthProgressing/Unhealthy/Unknown).
+	// +optional
+	ResourceHealthAtFailover string `json:"resourceHealthAtFailover,omitempty"`
+
+	// Attempt is the consecutive-failure count this failover represents
+	// against OriginCluster, mirroring FailoverBackoff.ConsecutiveFailures at
+	// the time this entry was appended.
+	// +optional
+	Attempt int `json:"attempt,omitempty"`
+}
+
+// FailoverBackoff records the exponential-backoff window computed for the
+// next failover attempt against a binding's current origin cluster.
+type FailoverBackoff struct {
+	// NextEligibleTime is the earliest time another failover may be
+	// triggered for this binding.
+	// +optional
+	NextEligibleTime *metav1.Time `json:"nextEligibleTime,omitempty"`
+
+	// ConsecutiveFailures is the number of failovers triggered back-to-back
+	// against the same origin cluster, used to compute the next backoff delay.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+}
+
+// ResourceBindingList contains a list of ResourceBinding.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourceBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items holds a list of ResourceBinding.
+	Items []ResourceBinding `json:"items"`
+}
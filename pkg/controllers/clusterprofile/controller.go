@@ -0,0 +1,225 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterprofile publishes Karmada Cluster objects as
+// `multicluster.x-k8s.io` ClusterProfile objects, so that a Karmada control
+// plane participating in an inventory-federated environment can be
+// discovered and scheduled against by third-party consumers.
+package clusterprofile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	clusterprofilev1alpha1 "sigs.k8s.io/cluster-inventory-api/apis/v1alpha1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/features"
+)
+
+const (
+	// ControllerName is the controller name that will be used when reporting events.
+	ControllerName = "cluster-profile-controller"
+
+	// clusterManagerName identifies Karmada as the ClusterManager publishing
+	// the ClusterProfile, per the Cluster Inventory API convention.
+	clusterManagerName = "karmada"
+
+	// clusterProfileFinalizer is added to every Cluster this controller has
+	// published a ClusterProfile for, so the ClusterProfile can be cleaned
+	// up before the Cluster is removed.
+	clusterProfileFinalizer = "karmada.io/cluster-profile-controller"
+
+	allocatableCPUProperty    = "karmada.io/allocatable-cpu"
+	allocatableMemoryProperty = "karmada.io/allocatable-memory"
+	allocatablePodsProperty   = "karmada.io/allocatable-pods"
+	taintsProperty            = "karmada.io/taints"
+)
+
+// Controller publishes each Karmada Cluster as a ClusterProfile.
+type Controller struct {
+	client.Client
+}
+
+var _ controllerruntime.Reconciler = (*Controller)(nil)
+
+// Reconcile performs a full reconciliation for the Cluster referred to by the Request,
+// keeping its mirrored ClusterProfile up to date.
+func (c *Controller) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).Infof("Reconciling ClusterProfile publication for cluster %s", req.Name)
+
+	cluster := &clusterv1alpha1.Cluster{}
+	if err := c.Client.Get(ctx, req.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+
+	// Deletion/finalizer cleanup must run regardless of the feature gate: a
+	// Cluster that got clusterProfileFinalizer added while the gate was on
+	// must still be able to shed it after the gate is turned off, or it
+	// hangs in Terminating forever. Only the publish path below is gated.
+	if !cluster.DeletionTimestamp.IsZero() {
+		if err := c.removeClusterProfile(ctx, cluster.Name); err != nil {
+			return controllerruntime.Result{}, err
+		}
+		return controllerruntime.Result{}, c.removeFinalizer(ctx, cluster)
+	}
+
+	if !features.FeatureGate.Enabled(features.ClusterInventoryAPI) {
+		return controllerruntime.Result{}, nil
+	}
+
+	if err := c.addFinalizer(ctx, cluster); err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	return controllerruntime.Result{}, c.syncClusterProfile(ctx, cluster)
+}
+
+func (c *Controller) syncClusterProfile(ctx context.Context, cluster *clusterv1alpha1.Cluster) error {
+	profile := &clusterprofilev1alpha1.ClusterProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: cluster.Name,
+		},
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, c.Client, profile, func() error {
+		profile.Labels = cluster.Labels
+		profile.Spec.DisplayName = cluster.Name
+		profile.Spec.ClusterManager = clusterprofilev1alpha1.ClusterManager{Name: clusterManagerName}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sync ClusterProfile for cluster %s: %w", cluster.Name, err)
+	}
+
+	klog.V(4).Infof("ClusterProfile for cluster %s %s", cluster.Name, result)
+
+	// CreateOrUpdate only writes the main resource; the /status subresource
+	// needs its own update call or the mirrored conditions and properties
+	// below are silently dropped.
+	profile.Status.Conditions = buildClusterProfileConditions(cluster)
+	profile.Status.Properties = buildClusterProfileProperties(cluster)
+	if err := c.Client.Status().Update(ctx, profile); err != nil {
+		return fmt.Errorf("failed to update ClusterProfile status for cluster %s: %w", cluster.Name, err)
+	}
+
+	return nil
+}
+
+func (c *Controller) removeClusterProfile(ctx context.Context, clusterName string) error {
+	profile := &clusterprofilev1alpha1.ClusterProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+	}
+	err := c.Client.Delete(ctx, profile)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove ClusterProfile for cluster %s: %w", clusterName, err)
+	}
+	return nil
+}
+
+func (c *Controller) addFinalizer(ctx context.Context, cluster *clusterv1alpha1.Cluster) error {
+	if controllerutil.ContainsFinalizer(cluster, clusterProfileFinalizer) {
+		return nil
+	}
+	controllerutil.AddFinalizer(cluster, clusterProfileFinalizer)
+	return c.Client.Update(ctx, cluster)
+}
+
+func (c *Controller) removeFinalizer(ctx context.Context, cluster *clusterv1alpha1.Cluster) error {
+	if !controllerutil.ContainsFinalizer(cluster, clusterProfileFinalizer) {
+		return nil
+	}
+	controllerutil.RemoveFinalizer(cluster, clusterProfileFinalizer)
+	return c.Client.Update(ctx, cluster)
+}
+
+// buildClusterProfileConditions sets the Cluster Inventory API's standard
+// ControlPlaneHealthy and Managed conditions on the ClusterProfile.
+// ControlPlaneHealthy copies the cluster's Ready condition (False with reason
+// ClusterNotReady when the cluster has none); Managed is always True.
+func buildClusterProfileConditions(cluster *clusterv1alpha1.Cluster) []metav1.Condition {
+	conditions := make([]metav1.Condition, 0, 2)
+
+	controlPlaneHealthy := metav1.Condition{
+		Type:               clusterprofilev1alpha1.ClusterConditionControlPlaneHealthy,
+		Status:             metav1.ConditionFalse,
+		Reason:             "ClusterNotReady",
+		LastTransitionTime: metav1.Now(),
+	}
+	if readyCondition := meta.FindStatusCondition(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady); readyCondition != nil {
+		controlPlaneHealthy.Status = readyCondition.Status
+		controlPlaneHealthy.Reason = readyCondition.Reason
+		controlPlaneHealthy.Message = readyCondition.Message
+		controlPlaneHealthy.LastTransitionTime = readyCondition.LastTransitionTime
+	}
+	conditions = append(conditions, controlPlaneHealthy)
+
+	conditions = append(conditions, metav1.Condition{
+		Type:               clusterprofilev1alpha1.ClusterConditionManaged,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ManagedByKarmada",
+		LastTransitionTime: metav1.Now(),
+	})
+
+	return conditions
+}
+
+// buildClusterProfileProperties mirrors cluster capacity and taints onto the
+// ClusterProfile's free-form Properties, since the Cluster Inventory API
+// doesn't define first-class fields for them.
+func buildClusterProfileProperties(cluster *clusterv1alpha1.Cluster) []clusterprofilev1alpha1.Property {
+	var properties []clusterprofilev1alpha1.Property
+
+	if cpu, ok := cluster.Status.ResourceSummary.Allocatable["cpu"]; ok {
+		properties = append(properties, clusterprofilev1alpha1.Property{Name: allocatableCPUProperty, Value: cpu.String()})
+	}
+	if memory, ok := cluster.Status.ResourceSummary.Allocatable["memory"]; ok {
+		properties = append(properties, clusterprofilev1alpha1.Property{Name: allocatableMemoryProperty, Value: memory.String()})
+	}
+	if pods, ok := cluster.Status.ResourceSummary.Allocatable["pods"]; ok {
+		properties = append(properties, clusterprofilev1alpha1.Property{Name: allocatablePodsProperty, Value: pods.String()})
+	}
+
+	if len(cluster.Spec.Taints) > 0 {
+		if encoded, err := json.Marshal(cluster.Spec.Taints); err == nil {
+			properties = append(properties, clusterprofilev1alpha1.Property{Name: taintsProperty, Value: string(encoded)})
+		} else {
+			klog.Errorf("Failed to encode taints for cluster %s: %v", cluster.Name, err)
+		}
+	}
+
+	return properties
+}
+
+// SetupWithManager creates a controller under the given controller-manager.
+func (c *Controller) SetupWithManager(mgr controllerruntime.Manager) error {
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&clusterv1alpha1.Cluster{}).
+		Complete(c)
+}
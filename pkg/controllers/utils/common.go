@@ -16,6 +16,7 @@ package utils
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/util/retry"
@@ -56,10 +57,30 @@ func restrictFailoverHistoryInfo(binding *workv1alpha2.ResourceBinding) bool {
 	return false
 }
 
-func UpdateFailoverStatus(client client.Client, binding *workv1alpha2.ResourceBinding, cluster string, failoverType string) (err error) {
+// UpdateFailoverStatus records a failover from cluster to destinationCluster
+// on binding's status. Before doing so, it checks two independent gates:
+//   - backoff: if binding is still within the exponential-backoff window
+//     recorded on its status by the previous failover, ErrFailoverThrottled
+//     is returned and no update is made. The window applies to the whole
+//     binding, whichever cluster the previous failover originated from.
+//   - readiness: if the resource's aggregated status on cluster shows it's
+//     still progressing rather than genuinely failed, the update is skipped
+//     (no error) since a mid-rollout hiccup isn't reason enough to failover.
+func UpdateFailoverStatus(client client.Client, binding *workv1alpha2.ResourceBinding, cluster, destinationCluster string, failoverType string) (err error) {
 	if restrictFailoverHistoryInfo(binding) {
 		return nil
 	}
+
+	now := time.Now()
+	if isFailoverThrottled(binding.Status.FailoverBackoff, now) {
+		return ErrFailoverThrottled
+	}
+
+	if isStillProgressing(binding, cluster) {
+		klog.V(4).Infof("Resource for binding %s/%s on cluster %s is still progressing, holding off failover", binding.Namespace, binding.Name, cluster)
+		return nil
+	}
+
 	message := fmt.Sprintf("Failover triggered for replica on cluster %s", cluster)
 
 	var reason string
@@ -81,16 +102,22 @@ func UpdateFailoverStatus(client client.Client, binding *workv1alpha2.ResourceBi
 		LastTransitionTime: metav1.Now(),
 	}
 
+	backoff := nextFailoverBackoff(binding.Status.FailoverHistory, cluster, now)
+
 	err = retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
 		_, err = helper.UpdateStatus(context.Background(), client, binding, func() error {
 			// set binding status with the newest condition
 			currentTime := metav1.Now()
 			failoverHistoryItem := workv1alpha2.FailoverHistoryItem{
-				FailoverTime:  &currentTime,
-				OriginCluster: cluster,
-				Reason:        reason,
+				FailoverTime:             &currentTime,
+				OriginCluster:            cluster,
+				DestinationCluster:       destinationCluster,
+				Reason:                   reason,
+				ResourceHealthAtFailover: resourceHealthAtFailover(binding, cluster),
+				Attempt:                  int(backoff.ConsecutiveFailures),
 			}
 			binding.Status.FailoverHistory = append(binding.Status.FailoverHistory, failoverHistoryItem)
+			binding.Status.FailoverBackoff = backoff
 			klog.V(4).Infof("Failover history is %+v", binding.Status.FailoverHistory)
 			existingCondition := meta.FindStatusCondition(binding.Status.Conditions, failoverType)
 			if existingCondition != nil && newFailoverAppliedCondition.Message == existingCondition.Message { //check
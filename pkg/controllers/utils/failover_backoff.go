@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+const (
+	failoverBackoffBaseDelay = 10 * time.Second
+	failoverBackoffMaxDelay  = 10 * time.Minute
+
+	// failoverBackoffWindow bounds how many of the most recent history
+	// entries are walked when counting consecutive failures against the
+	// same origin cluster.
+	failoverBackoffWindow = 10
+)
+
+// ErrFailoverThrottled is returned by UpdateFailoverStatus when the binding is
+// still inside the exponential-backoff window recorded by its previous
+// failover, so the caller should skip triggering another failover this cycle.
+var ErrFailoverThrottled = errors.New("failover throttled: binding is within its failover backoff window")
+
+// isFailoverThrottled reports whether binding is still within the backoff
+// window recorded the last time a failover was attempted.
+func isFailoverThrottled(backoff *workv1alpha2.FailoverBackoff, now time.Time) bool {
+	if backoff == nil || backoff.NextEligibleTime == nil {
+		return false
+	}
+	return now.Before(backoff.NextEligibleTime.Time)
+}
+
+// nextFailoverBackoff computes the FailoverBackoff to record for a new
+// failover attempt against originCluster, given the binding's existing
+// FailoverHistory. The backoff window grows exponentially with the number of
+// consecutive failures against the same origin cluster:
+// delay = min(baseDelay * 2^n, maxDelay), plus up to 50% jitter so that
+// bindings evicted from the same cluster at the same time don't all retry in
+// lockstep.
+func nextFailoverBackoff(history []workv1alpha2.FailoverHistoryItem, originCluster string, now time.Time) *workv1alpha2.FailoverBackoff {
+	consecutiveFailures := consecutiveFailuresFor(history, originCluster)
+	delay := failoverBackoffDelay(consecutiveFailures)
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1)) // #nosec G404 -- jitter doesn't need to be cryptographically secure
+
+	nextEligible := metav1.NewTime(now.Add(delay + jitter))
+	return &workv1alpha2.FailoverBackoff{
+		NextEligibleTime:    &nextEligible,
+		ConsecutiveFailures: consecutiveFailures + 1,
+	}
+}
+
+// consecutiveFailuresFor counts how many of the most recent
+// failoverBackoffWindow history entries were consecutively against
+// originCluster, i.e. not interrupted by a failover originating elsewhere.
+func consecutiveFailuresFor(history []workv1alpha2.FailoverHistoryItem, originCluster string) int32 {
+	var count int32
+	start := 0
+	if len(history) > failoverBackoffWindow {
+		start = len(history) - failoverBackoffWindow
+	}
+	for i := len(history) - 1; i >= start; i-- {
+		if history[i].OriginCluster != originCluster {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// failoverBackoffDelay returns the exponential backoff delay for the n-th
+// consecutive failure against the same origin cluster, capped at
+// failoverBackoffMaxDelay.
+func failoverBackoffDelay(n int32) time.Duration {
+	delay := float64(failoverBackoffBaseDelay) * math.Pow(2, float64(n))
+	if delay > float64(failoverBackoffMaxDelay) || math.IsInf(delay, 1) {
+		return failoverBackoffMaxDelay
+	}
+	return time.Duration(delay)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+func TestFailoverBackoffDelayGrowsExponentiallyAndCaps(t *testing.T) {
+	prev := time.Duration(0)
+	for n := int32(0); n < 6; n++ {
+		delay := failoverBackoffDelay(n)
+		if delay < prev {
+			t.Fatalf("failoverBackoffDelay(%d) = %v, want >= previous delay %v", n, delay, prev)
+		}
+		prev = delay
+	}
+
+	if got := failoverBackoffDelay(0); got != failoverBackoffBaseDelay {
+		t.Fatalf("failoverBackoffDelay(0) = %v, want base delay %v", got, failoverBackoffBaseDelay)
+	}
+	if got := failoverBackoffDelay(20); got != failoverBackoffMaxDelay {
+		t.Fatalf("failoverBackoffDelay(20) = %v, want capped at max delay %v", got, failoverBackoffMaxDelay)
+	}
+}
+
+func TestConsecutiveFailuresForStopsAtDifferentOriginCluster(t *testing.T) {
+	history := []workv1alpha2.FailoverHistoryItem{
+		{OriginCluster: "member2"},
+		{OriginCluster: "member1"},
+		{OriginCluster: "member1"},
+		{OriginCluster: "member1"},
+	}
+
+	if got := consecutiveFailuresFor(history, "member1"); got != 3 {
+		t.Fatalf("consecutiveFailuresFor() = %d, want 3", got)
+	}
+	if got := consecutiveFailuresFor(history, "member2"); got != 0 {
+		t.Fatalf("consecutiveFailuresFor() = %d, want 0: most recent entry is against member1, not member2", got)
+	}
+}
+
+func TestConsecutiveFailuresForRespectsWindow(t *testing.T) {
+	history := make([]workv1alpha2.FailoverHistoryItem, 0, failoverBackoffWindow+5)
+	for i := 0; i < failoverBackoffWindow+5; i++ {
+		history = append(history, workv1alpha2.FailoverHistoryItem{OriginCluster: "member1"})
+	}
+
+	if got := consecutiveFailuresFor(history, "member1"); got != failoverBackoffWindow {
+		t.Fatalf("consecutiveFailuresFor() = %d, want capped at window size %d", got, failoverBackoffWindow)
+	}
+}
+
+func TestIsFailoverThrottled(t *testing.T) {
+	now := time.Now()
+
+	if isFailoverThrottled(nil, now) {
+		t.Fatal("isFailoverThrottled(nil) = true, want false")
+	}
+
+	future := metav1.NewTime(now.Add(time.Minute))
+	throttled := &workv1alpha2.FailoverBackoff{NextEligibleTime: &future}
+	if !isFailoverThrottled(throttled, now) {
+		t.Fatal("isFailoverThrottled() = false, want true: now is before NextEligibleTime")
+	}
+
+	past := metav1.NewTime(now.Add(-time.Minute))
+	eligible := &workv1alpha2.FailoverBackoff{NextEligibleTime: &past}
+	if isFailoverThrottled(eligible, now) {
+		t.Fatal("isFailoverThrottled() = true, want false: now is after NextEligibleTime")
+	}
+}
+
+// TestNextFailoverBackoffFlapPrevention asserts that repeated failovers
+// against the same origin cluster produce a strictly growing
+// ConsecutiveFailures count and a NextEligibleTime that moves further into
+// the future each time, so a flapping cluster gets progressively throttled.
+func TestNextFailoverBackoffFlapPrevention(t *testing.T) {
+	now := time.Now()
+	var history []workv1alpha2.FailoverHistoryItem
+
+	var prevNextEligible time.Time
+	for i := 0; i < 3; i++ {
+		backoff := nextFailoverBackoff(history, "member1", now)
+		if backoff.ConsecutiveFailures != int32(i+1) {
+			t.Fatalf("round %d: ConsecutiveFailures = %d, want %d", i, backoff.ConsecutiveFailures, i+1)
+		}
+		if !backoff.NextEligibleTime.Time.After(prevNextEligible) {
+			t.Fatalf("round %d: NextEligibleTime %v did not move later than previous round's %v", i, backoff.NextEligibleTime.Time, prevNextEligible)
+		}
+		prevNextEligible = backoff.NextEligibleTime.Time
+
+		history = append(history, workv1alpha2.FailoverHistoryItem{OriginCluster: "member1"})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// Resource health snapshots recorded on workv1alpha2.FailoverHistoryItem.ResourceHealthAtFailover.
+const (
+	ResourceHealthProgressing = "Progressing"
+	ResourceHealthUnhealthy   = "Unhealthy"
+	ResourceHealthUnknown     = "Unknown"
+)
+
+// resourceHealthAtFailover summarizes the health of the resource on cluster
+// at the moment a failover is recorded, for display in the RB status
+// printer. UpdateFailoverStatus only calls this once isStillProgressing has
+// already gated the failover on cluster being false, so ResourceHealthProgressing
+// is never returned here; it remains a valid FailoverHistoryItem value for
+// callers that record health independently of that gate.
+func resourceHealthAtFailover(binding *workv1alpha2.ResourceBinding, cluster string) string {
+	for _, item := range binding.Status.AggregatedStatus {
+		if item.ClusterName == cluster && item.Status != nil {
+			return ResourceHealthUnhealthy
+		}
+	}
+	return ResourceHealthUnknown
+}
+
+// isStillProgressing inspects the aggregated per-cluster status already
+// collected on the binding for cluster, and reports whether the underlying
+// resource looks like it's still rolling out rather than genuinely failed.
+// Mirroring Helm's resource-status check, "still progressing" is treated as
+// insufficient reason to failover on its own: only the manifest kinds below
+// are understood; anything else (or a status we fail to parse) is treated as
+// not-progressing so failover isn't blocked on eviction signals alone.
+func isStillProgressing(binding *workv1alpha2.ResourceBinding, cluster string) bool {
+	for _, item := range binding.Status.AggregatedStatus {
+		if item.ClusterName != cluster || item.Status == nil {
+			continue
+		}
+
+		switch binding.Spec.Resource.Kind {
+		case "Deployment":
+			return deploymentStillProgressing(item.Status.Raw)
+		case "StatefulSet":
+			return statefulSetStillProgressing(item.Status.Raw)
+		case "DaemonSet":
+			return daemonSetStillProgressing(item.Status.Raw)
+		case "Job":
+			return jobStillProgressing(item.Status.Raw)
+		case "PersistentVolumeClaim":
+			return pvcStillProgressing(item.Status.Raw)
+		case "Service":
+			return false
+		}
+	}
+
+	return false
+}
+
+func deploymentStillProgressing(raw []byte) bool {
+	var status appsv1.DeploymentStatus
+	if err := json.Unmarshal(raw, &status); err != nil {
+		klog.Errorf("Failed to unmarshal Deployment status for failover readiness check: %v", err)
+		return false
+	}
+	for _, cond := range status.Conditions {
+		if cond.Type == appsv1.DeploymentReplicaFailure && cond.Status == corev1.ConditionTrue {
+			return false // genuinely failed: don't hold off failover
+		}
+	}
+	for _, cond := range status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing {
+			// NewReplicaSetAvailable means the rollout has completed, not that
+			// one is still underway.
+			return cond.Status == corev1.ConditionTrue && cond.Reason != "ProgressDeadlineExceeded" && cond.Reason != "NewReplicaSetAvailable"
+		}
+	}
+	// No Progressing condition was populated at all: fall back to whether a
+	// rollout is actually underway (some, but not all, replicas updated) -
+	// not mere under-replication, which a genuinely broken deployment (0
+	// ready, nothing updating) would also match and so never fail over.
+	return status.UpdatedReplicas > 0 && status.UpdatedReplicas < status.Replicas
+}
+
+func statefulSetStillProgressing(raw []byte) bool {
+	var status appsv1.StatefulSetStatus
+	if err := json.Unmarshal(raw, &status); err != nil {
+		klog.Errorf("Failed to unmarshal StatefulSet status for failover readiness check: %v", err)
+		return false
+	}
+	// A rollout is underway when some, but not all, replicas are updated; mere
+	// under-readiness would also match a genuinely broken StatefulSet.
+	return status.UpdatedReplicas > 0 && status.UpdatedReplicas < status.Replicas
+}
+
+func daemonSetStillProgressing(raw []byte) bool {
+	var status appsv1.DaemonSetStatus
+	if err := json.Unmarshal(raw, &status); err != nil {
+		klog.Errorf("Failed to unmarshal DaemonSet status for failover readiness check: %v", err)
+		return false
+	}
+	return status.NumberUnavailable > 0 && status.NumberUnavailable < status.DesiredNumberScheduled
+}
+
+func jobStillProgressing(raw []byte) bool {
+	var status batchv1.JobStatus
+	if err := json.Unmarshal(raw, &status); err != nil {
+		klog.Errorf("Failed to unmarshal Job status for failover readiness check: %v", err)
+		return false
+	}
+	if status.Failed > 0 {
+		return false // actual failure: don't hold off failover
+	}
+	return status.Active > 0
+}
+
+func pvcStillProgressing(raw []byte) bool {
+	var status corev1.PersistentVolumeClaimStatus
+	if err := json.Unmarshal(raw, &status); err != nil {
+		klog.Errorf("Failed to unmarshal PersistentVolumeClaim status for failover readiness check: %v", err)
+		return false
+	}
+	return status.Phase == corev1.ClaimPending
+}
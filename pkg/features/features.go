@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// WorkloadAffinity gates the AntiAffinity/Affinity scheduler plugins that
+	// keep apart or co-locate ResourceBindings sharing an AffinityGroupLabel value.
+	WorkloadAffinity featuregate.Feature = "WorkloadAffinity"
+
+	// ClusterInventoryAPI gates populating the scheduler snapshot from
+	// multicluster.x-k8s.io ClusterProfile objects via the Cluster Inventory
+	// API, in addition to Karmada's native Cluster objects, and publishing
+	// Karmada Clusters as ClusterProfiles for third-party consumers.
+	ClusterInventoryAPI featuregate.Feature = "ClusterInventoryAPI"
+)
+
+// FeatureGate is a shared global FeatureGate.
+var FeatureGate = featuregate.NewFeatureGate()
+
+var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	WorkloadAffinity:    {Default: false, PreRelease: featuregate.Alpha},
+	ClusterInventoryAPI: {Default: false, PreRelease: featuregate.Alpha},
+}
+
+func init() {
+	utilruntime.Must(FeatureGate.Add(defaultFeatureGates))
+}
@@ -18,18 +18,35 @@ package cache
 
 import (
 	"sync"
+	"sync/atomic"
 
-	"k8s.io/apimachinery/pkg/labels"
+	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/features"
-	clusterlister "github.com/karmada-io/karmada/pkg/generated/listers/cluster/v1alpha1"
 )
 
+var (
+	snapshotReuseTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "karmada_scheduler_cache_snapshot_reuse_total",
+		Help: "Number of Snapshot() calls that returned the previously built snapshot unchanged because the cache generation had not advanced.",
+	})
+	snapshotRebuildTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "karmada_scheduler_cache_snapshot_rebuild_total",
+		Help: "Number of Snapshot() calls that had to rebuild some or all of the cached snapshot because the cache generation had advanced.",
+	})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(snapshotReuseTotal, snapshotRebuildTotal)
+}
+
 // Cache is an interface for scheduler internal cache.
 type Cache interface {
 	AddCluster(cluster *clusterv1alpha1.Cluster)
@@ -43,83 +60,266 @@ type Cache interface {
 	OnResourceBindingDelete(obj interface{})
 }
 
+// AffinityMode distinguishes which kind of relation a cache shard was
+// indexed for, so that a single label key can be used by an anti-affinity
+// term on one binding and an affinity term on another without their peer
+// lists colliding.
+type AffinityMode string
+
+const (
+	// AntiAffinityRequired marks a shard built from a WorkloadAffinity term
+	// in RequiredDuringScheduling mode: the Filter plugin hard-rejects a
+	// candidate cluster already occupied by a peer.
+	AntiAffinityRequired AffinityMode = "AntiAffinityRequired"
+	// AntiAffinityPreferred marks a shard built from a WorkloadAffinity term
+	// in PreferredDuringScheduling mode: only the Score plugin acts on it.
+	AntiAffinityPreferred AffinityMode = "AntiAffinityPreferred"
+	// AffinityRequired marks a shard built from an Affinity term in
+	// RequiredDuringScheduling mode: the Filter plugin hard-rejects a
+	// candidate cluster with no peer already placed there.
+	AffinityRequired AffinityMode = "AffinityRequired"
+	// AffinityPreferred marks a shard built from an Affinity term in
+	// PreferredDuringScheduling mode: only the Score plugin acts on it.
+	AffinityPreferred AffinityMode = "AffinityPreferred"
+)
+
+// ClusterNameTopologyKey is the implicit topology key standing for a
+// cluster's own identity. Every RB is indexed under it regardless of whether
+// it configures a TopologyKey, so plugins that only care about exact-cluster
+// occupancy (the pre-topology-key behavior) don't need special-casing.
+const ClusterNameTopologyKey = "kubernetes.io/cluster-name"
+
 type AntiKey struct {
-	Namespace  string
-	LabelKey   string
-	GroupValue string
+	Namespace    string
+	LabelKey     string
+	GroupValue   string
+	AffinityMode AffinityMode
 }
 
-func MakeAntiKey(ns, key, value string) AntiKey {
-	return AntiKey{ns, key, value}
+func MakeAntiKey(ns, key, value string, mode AffinityMode) AntiKey {
+	return AntiKey{ns, key, value, mode}
 }
 
 type schedulerCache struct {
-	clusterLister  clusterlister.ClusterLister
-	mu             sync.RWMutex
+	// sources supplies the clusters a Snapshot is built from. In addition to
+	// the native Karmada clusterLister, this may include ClusterSources
+	// backed by third-party inventories (e.g. Cluster Inventory API
+	// ClusterProfile objects); Snapshot merges and de-duplicates across them.
+	sources []ClusterSource
+	mu      sync.RWMutex
+
+	// generation is bumped every time indexRB/unindexRB or a cluster event
+	// touches the cache. Snapshot() uses it to decide whether the previously
+	// built snapshot can be returned verbatim (copy-on-write, no allocation)
+	// or whether some shards need to be re-derived.
+	generation int64
+
 	affinityGroups map[AntiKey][]string // antiKey -> []rbID
-	clustersByRB   map[string]sets.Set[string]
+
+	// rbClusterNames and rbTopologyKey hold, per rbID, the raw data Snapshot
+	// derives its topology-domain maps from: the RB's target cluster names,
+	// and the TopologyKey its WorkloadAffinity term (if any) is configured
+	// with.
+	rbClusterNames map[string]sets.Set[string]
+	rbTopologyKey  map[string]string
+
+	// clusterLabels mirrors each known cluster's labels, so topology-domain
+	// lookups don't need to go back out to a ClusterSource. Kept up to date
+	// by AddCluster/UpdateCluster/DeleteCluster.
+	clusterLabels map[string]map[string]string
+
+	// keyGeneration/rbGeneration record the generation at which each shard of
+	// affinityGroups/rbClusterNames was last replaced, so Snapshot can tell
+	// which shards moved since the last snapshot without diffing the maps.
+	keyGeneration sync.Map // AntiKey -> int64
+	rbGeneration  sync.Map // rbID(string) -> int64
+
+	// snapshotMu guards the cached snapshot below. It is separate from mu so
+	// that building a snapshot never blocks indexRB/unindexRB for longer than
+	// it takes to read the live maps.
+	snapshotMu       sync.Mutex
+	cachedSnapshot   Snapshot
+	cachedGeneration int64
+	cachedValid      bool
 }
 
-// NewCache instantiates a cache used only by scheduler.
-func NewCache(clusterLister clusterlister.ClusterLister) Cache {
+// NewCache instantiates a cache used only by scheduler. sources are consulted,
+// in order, when a Snapshot lists clusters; if the same cluster name is
+// returned by more than one source, the first one wins. Callers typically
+// pass NewClusterListerSource(clusterLister) first, optionally followed by
+// NewClusterProfileSource(...) to also schedule against clusters registered
+// through the Cluster Inventory API.
+func NewCache(sources ...ClusterSource) Cache {
 	return &schedulerCache{
-		clusterLister:  clusterLister,
+		sources:        sources,
 		affinityGroups: make(map[AntiKey][]string),
-		clustersByRB:   make(map[string]sets.Set[string]),
+		rbClusterNames: make(map[string]sets.Set[string]),
+		rbTopologyKey:  make(map[string]string),
+		clusterLabels:  make(map[string]map[string]string),
 	}
 }
 
-// AddCluster does nothing since clusterLister would synchronize automatically
-func (c *schedulerCache) AddCluster(_ *clusterv1alpha1.Cluster) {
+// AddCluster records the cluster's labels for topology-key lookups and bumps
+// the generation so the next Snapshot() picks up the change; clusterLister
+// synchronization itself needs no further action here.
+func (c *schedulerCache) AddCluster(cluster *clusterv1alpha1.Cluster) {
+	c.mu.Lock()
+	c.clusterLabels[cluster.Name] = cluster.Labels
+	c.mu.Unlock()
+
+	atomic.AddInt64(&c.generation, 1)
 }
 
-// UpdateCluster does nothing since clusterLister would synchronize automatically
-func (c *schedulerCache) UpdateCluster(_ *clusterv1alpha1.Cluster) {
+// UpdateCluster refreshes the cluster's cached labels and bumps the
+// generation, so that on the next Snapshot() any RB whose TopologyKey domain
+// depends on this cluster's labels gets re-derived.
+func (c *schedulerCache) UpdateCluster(cluster *clusterv1alpha1.Cluster) {
+	c.mu.Lock()
+	c.clusterLabels[cluster.Name] = cluster.Labels
+	c.mu.Unlock()
+
+	atomic.AddInt64(&c.generation, 1)
 }
 
-// DeleteCluster does nothing since clusterLister would synchronize automatically
-func (c *schedulerCache) DeleteCluster(_ *clusterv1alpha1.Cluster) {
+// DeleteCluster drops the cluster's cached labels and bumps the generation so
+// the next Snapshot() picks up the change.
+func (c *schedulerCache) DeleteCluster(cluster *clusterv1alpha1.Cluster) {
+	c.mu.Lock()
+	delete(c.clusterLabels, cluster.Name)
+	c.mu.Unlock()
+
+	atomic.AddInt64(&c.generation, 1)
 }
 
-// Snapshot returns clusters' snapshot.
-// **TODO: Needs optimization, only clone when necessary
+// Snapshot returns clusters' snapshot. If nothing has changed in the cache
+// since the last call, the previously built Snapshot is returned verbatim
+// (O(1), no allocation). Otherwise rebuildIndexes is invoked: the content of
+// each individual shard/RB entry that wasn't touched since the previous
+// snapshot is reused by reference rather than re-derived, so concurrent
+// readers holding an older Snapshot continue to see a consistent view. The
+// top-level affinityGroups/clusterDomainsByRB maps themselves are still
+// reallocated and repopulated in full on any generation bump, since Go's
+// built-in maps have no structural sharing to exploit for a cheaper
+// top-level copy; that part of the work is O(total shards/RBs), not O(1)
+// per change.
 func (c *schedulerCache) Snapshot() Snapshot {
-	out := NewEmptySnapshot()
-	clusters, err := c.clusterLister.List(labels.Everything())
-	if err != nil {
-		klog.Errorf("Failed to list clusters: %v", err)
-		return out
+	c.snapshotMu.Lock()
+	defer c.snapshotMu.Unlock()
+
+	currentGen := atomic.LoadInt64(&c.generation)
+	if c.cachedValid && c.cachedGeneration == currentGen {
+		snapshotReuseTotal.Inc()
+		return c.cachedSnapshot
 	}
+	snapshotRebuildTotal.Inc()
 
-	out.clusters = make([]*clusterv1alpha1.Cluster, 0, len(clusters))
+	out := NewEmptySnapshot()
+	out.generation = currentGen
 
-	for _, cluster := range clusters {
-		out.clusters = append(out.clusters, cluster.DeepCopy())
-	}
+	out.clusters = c.mergeClustersFromSources()
 
 	// If we have WorkloadAffinity feature enabled, we should index our RBs
 	if features.FeatureGate.Enabled(features.WorkloadAffinity) {
-		c.mu.RLock()
-		defer c.mu.RUnlock()
-
-		out.affinityGroups = make(map[AntiKey][]string, len(c.affinityGroups))
-		for k, v := range c.affinityGroups {
-			vv := make([]string, len(v))
-			copy(vv, v)
-			out.affinityGroups[k] = vv
+		out.affinityGroups, out.clusterDomainsByRB = c.rebuildIndexes(currentGen)
+	}
+
+	c.cachedSnapshot = out
+	c.cachedGeneration = currentGen
+	c.cachedValid = true
+
+	return out
+}
+
+// mergeClustersFromSources lists clusters from every configured ClusterSource
+// and de-duplicates the result by cluster name, earlier sources taking
+// precedence over later ones.
+func (c *schedulerCache) mergeClustersFromSources() []*clusterv1alpha1.Cluster {
+	seen := make(map[string]struct{})
+	merged := make([]*clusterv1alpha1.Cluster, 0)
+
+	for _, source := range c.sources {
+		clusters, err := source.List()
+		if err != nil {
+			klog.Errorf("Failed to list clusters from source %s: %v", source.Name(), err)
+			continue
 		}
 
-		out.clustersByRB = make(map[string]sets.Set[string], len(c.clustersByRB))
-		for rbID, set := range c.clustersByRB {
-			s := sets.New[string]()
-			for item := range set {
-				s.Insert(item)
+		for _, cluster := range clusters {
+			if _, ok := seen[cluster.Name]; ok {
+				continue
 			}
-			out.clustersByRB[rbID] = s
+			seen[cluster.Name] = struct{}{}
+			merged = append(merged, cluster.DeepCopy())
 		}
 	}
 
-	return out
+	return merged
+}
+
+// rebuildIndexes produces the affinityGroups/clusterDomainsByRB maps for the
+// snapshot currently being built at currentGen. affinityGroups shards that
+// haven't been touched since the previous snapshot are reused by reference
+// from it. clusterDomainsByRB entries for an RB with no TopologyKey are
+// likewise reused when untouched; an RB with a TopologyKey is always
+// re-derived from the live cluster labels, since those can change
+// independently of the RB's own generation stamp.
+//
+// This only keys the *content* of the rebuild off changed shards: building
+// the two top-level maps themselves still walks every key in
+// c.affinityGroups/c.rbClusterNames every time this runs, since Go's maps
+// can't be copied cheaper than that. So a cache under steady churn pays
+// O(total shards/RBs) per Snapshot(), not O(1) per change; only a fully
+// idle cache gets the O(1) path, via Snapshot()'s generation check above.
+func (c *schedulerCache) rebuildIndexes(currentGen int64) (map[AntiKey][]string, map[string]map[string]sets.Set[string]) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	prevAffinityGroups := c.cachedSnapshot.affinityGroups
+	prevClusterDomainsByRB := c.cachedSnapshot.clusterDomainsByRB
+
+	affinityGroups := make(map[AntiKey][]string, len(c.affinityGroups))
+	for key, live := range c.affinityGroups {
+		if gen, ok := c.keyGeneration.Load(key); ok && gen.(int64) > c.cachedGeneration {
+			// Touched since the last snapshot: indexRB/unindexRB always
+			// allocate a fresh slice rather than mutating in place, so it's
+			// safe to share this slice header with the new snapshot.
+			affinityGroups[key] = live
+			continue
+		}
+		if cached, ok := prevAffinityGroups[key]; ok {
+			affinityGroups[key] = cached
+			continue
+		}
+		affinityGroups[key] = live
+	}
+
+	clusterDomainsByRB := make(map[string]map[string]sets.Set[string], len(c.rbClusterNames))
+	for rbID, clusterNames := range c.rbClusterNames {
+		topologyKey := c.rbTopologyKey[rbID]
+		if topologyKey == "" {
+			if gen, ok := c.rbGeneration.Load(rbID); !ok || gen.(int64) <= c.cachedGeneration {
+				if cached, ok := prevClusterDomainsByRB[rbID]; ok {
+					clusterDomainsByRB[rbID] = cached
+					continue
+				}
+			}
+			clusterDomainsByRB[rbID] = map[string]sets.Set[string]{ClusterNameTopologyKey: clusterNames}
+			continue
+		}
+
+		domainValues := sets.New[string]()
+		for name := range clusterNames {
+			if value := c.clusterLabels[name][topologyKey]; value != "" {
+				domainValues.Insert(value)
+			}
+		}
+		clusterDomainsByRB[rbID] = map[string]sets.Set[string]{
+			ClusterNameTopologyKey: clusterNames,
+			topologyKey:            domainValues,
+		}
+	}
+
+	return affinityGroups, clusterDomainsByRB
 }
 
 func (c *schedulerCache) OnResourceBindingAdd(obj interface{}) {
@@ -149,18 +349,44 @@ func (c *schedulerCache) OnResourceBindingDelete(obj interface{}) {
 	c.unindexRB(rb)
 }
 
-func (c *schedulerCache) indexRB(rb *workv1alpha2.ResourceBinding) {
-	if len(rb.Spec.Clusters) == 0 {
-		return
+// antiKeysFor returns the cache keys rb should be indexed under: one for its
+// WorkloadAffinity (anti-affinity) term and one for its Affinity (positive
+// affinity) term, whichever are set. Both terms share the same
+// AffinityGroupLabel lookup on the resource, but are kept in separate shards
+// via AffinityMode so they never collide.
+func antiKeysFor(rb *workv1alpha2.ResourceBinding) []AntiKey {
+	var keys []AntiKey
+
+	if term := rb.Spec.Placement.WorkloadAffinity; term != nil && term.AffinityLabelKey != "" {
+		if groupValue := rb.Spec.Resource.AffinityGroupLabel[term.AffinityLabelKey]; groupValue != "" {
+			mode := AntiAffinityRequired
+			if term.Mode == policyv1alpha1.PreferredDuringScheduling {
+				mode = AntiAffinityPreferred
+			}
+			keys = append(keys, MakeAntiKey(rb.Namespace, term.AffinityLabelKey, groupValue, mode))
+		}
 	}
 
-	affinityTerm := rb.Spec.Placement.WorkloadAffinity
-	if affinityTerm == nil || affinityTerm.AffinityLabelKey == "" {
+	if term := rb.Spec.Placement.Affinity; term != nil && term.AffinityLabelKey != "" {
+		if groupValue := rb.Spec.Resource.AffinityGroupLabel[term.AffinityLabelKey]; groupValue != "" {
+			mode := AffinityRequired
+			if term.Mode == policyv1alpha1.PreferredDuringScheduling {
+				mode = AffinityPreferred
+			}
+			keys = append(keys, MakeAntiKey(rb.Namespace, term.AffinityLabelKey, groupValue, mode))
+		}
+	}
+
+	return keys
+}
+
+func (c *schedulerCache) indexRB(rb *workv1alpha2.ResourceBinding) {
+	if len(rb.Spec.Clusters) == 0 {
 		return
 	}
 
-	groupValue := rb.Spec.Resource.AffinityGroupLabel[affinityTerm.AffinityLabelKey]
-	if groupValue == "" {
+	keys := antiKeysFor(rb)
+	if len(keys) == 0 {
 		return
 	}
 
@@ -170,34 +396,52 @@ func (c *schedulerCache) indexRB(rb *workv1alpha2.ResourceBinding) {
 		clusters.Insert(target.Name)
 	}
 
-	key := MakeAntiKey(rb.Namespace, affinityTerm.AffinityLabelKey, groupValue)
-
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.affinityGroups[key] = append(c.affinityGroups[key], rbID)
-	c.clustersByRB[rbID] = clusters
-}
+	gen := atomic.AddInt64(&c.generation, 1)
+
+	for _, key := range keys {
+		// Allocate a brand new backing array rather than appending in place,
+		// so that a Snapshot taken before this call keeps seeing the old slice.
+		existing := c.affinityGroups[key]
+		updated := make([]string, len(existing), len(existing)+1)
+		copy(updated, existing)
+		updated = append(updated, rbID)
+		c.affinityGroups[key] = updated
+		c.keyGeneration.Store(key, gen)
+	}
 
-func (c *schedulerCache) unindexRB(rb *workv1alpha2.ResourceBinding) {
-	affinityTerm := rb.Spec.Placement.WorkloadAffinity
-	if affinityTerm == nil || affinityTerm.AffinityLabelKey == "" {
-		return
+	c.rbClusterNames[rbID] = clusters
+	if term := rb.Spec.Placement.WorkloadAffinity; term != nil {
+		c.rbTopologyKey[rbID] = term.TopologyKey
+	} else {
+		delete(c.rbTopologyKey, rbID)
 	}
+	c.rbGeneration.Store(rbID, gen)
+}
 
-	groupValue := rb.Spec.Resource.AffinityGroupLabel[affinityTerm.AffinityLabelKey]
-	if groupValue == "" {
+func (c *schedulerCache) unindexRB(rb *workv1alpha2.ResourceBinding) {
+	keys := antiKeysFor(rb)
+	if len(keys) == 0 {
 		return
 	}
 
 	rbID := rb.Namespace + "/" + rb.Name
-	key := MakeAntiKey(rb.Namespace, affinityTerm.AffinityLabelKey, groupValue)
 
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if slice, ok := c.affinityGroups[key]; ok {
-		filtered := slice[:0]
+	gen := atomic.AddInt64(&c.generation, 1)
+
+	for _, key := range keys {
+		slice, ok := c.affinityGroups[key]
+		if !ok {
+			continue
+		}
+		// Allocate a new backing array rather than filtering in place, so
+		// that a Snapshot taken before this call keeps seeing the old slice.
+		filtered := make([]string, 0, len(slice))
 		for _, id := range slice {
 			if id != rbID {
 				filtered = append(filtered, id)
@@ -205,12 +449,20 @@ func (c *schedulerCache) unindexRB(rb *workv1alpha2.ResourceBinding) {
 		}
 		if len(filtered) == 0 {
 			delete(c.affinityGroups, key)
+			// The shard is gone, so rebuildIndexes will never iterate this
+			// key again; keeping its stamp around would leak it forever.
+			c.keyGeneration.Delete(key)
 		} else {
 			c.affinityGroups[key] = filtered
+			c.keyGeneration.Store(key, gen)
 		}
 	}
 
-	delete(c.clustersByRB, rbID)
+	delete(c.rbClusterNames, rbID)
+	delete(c.rbTopologyKey, rbID)
+	// rbID is gone from rbClusterNames, so rebuildIndexes will never iterate
+	// it again; keeping its stamp around would leak it forever.
+	c.rbGeneration.Delete(rbID)
 }
 
 func getRBFromObj(obj interface{}) *workv1alpha2.ResourceBinding {
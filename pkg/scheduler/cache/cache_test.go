@@ -0,0 +1,128 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
+)
+
+// fakeClusterSource is a ClusterSource backed by an in-memory slice, for tests.
+type fakeClusterSource struct {
+	clusters []*clusterv1alpha1.Cluster
+}
+
+func (s *fakeClusterSource) Name() string { return "fake" }
+
+func (s *fakeClusterSource) List() ([]*clusterv1alpha1.Cluster, error) {
+	return s.clusters, nil
+}
+
+func newTestCluster(name string) *clusterv1alpha1.Cluster {
+	return &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
+
+func newTestRB(namespace, name string, clusters ...string) *workv1alpha2.ResourceBinding {
+	rb := &workv1alpha2.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}
+	for _, c := range clusters {
+		rb.Spec.Clusters = append(rb.Spec.Clusters, workv1alpha2.TargetCluster{Name: c})
+	}
+	return rb
+}
+
+// TestSnapshotIdleIsReused asserts that calling Snapshot() repeatedly with no
+// intervening cache mutation returns the cached Snapshot verbatim (O(1): no
+// rebuild of affinityGroups/clusterDomainsByRB), per the snapshot-reuse
+// counter rather than by relying on timing.
+func TestSnapshotIdleIsReused(t *testing.T) {
+	c := NewCache(&fakeClusterSource{clusters: []*clusterv1alpha1.Cluster{newTestCluster("member1")}})
+
+	first := c.Snapshot()
+	reuseBefore := testutil.ToFloat64(snapshotReuseTotal)
+
+	for i := 0; i < 5; i++ {
+		again := c.Snapshot()
+		if again.Generation() != first.Generation() {
+			t.Fatalf("idle Snapshot() generation changed: got %d, want %d", again.Generation(), first.Generation())
+		}
+	}
+
+	if got, want := testutil.ToFloat64(snapshotReuseTotal)-reuseBefore, float64(5); got != want {
+		t.Fatalf("expected %v idle Snapshot() calls to hit the reuse path, got %v", want, got)
+	}
+}
+
+// TestSnapshotConsistentUnderConcurrentRBChurn asserts that a Snapshot taken
+// concurrently with indexRB/unindexRB churn never observes a torn/partial
+// view: the peer list read out of a given Snapshot's affinityGroups never
+// changes after the Snapshot was taken, since indexRB and unindexRB always
+// replace slices wholesale rather than mutating them in place.
+func TestSnapshotConsistentUnderConcurrentRBChurn(t *testing.T) {
+	// affinityGroups is only populated in a Snapshot with WorkloadAffinity on.
+	if err := features.FeatureGate.Set(string(features.WorkloadAffinity) + "=true"); err != nil {
+		t.Fatalf("failed to enable %s feature gate: %v", features.WorkloadAffinity, err)
+	}
+	defer func() { _ = features.FeatureGate.Set(string(features.WorkloadAffinity) + "=false") }()
+
+	sc := NewCache(&fakeClusterSource{}).(*schedulerCache)
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				rb := newTestRB("default", "rb", "member1")
+				rb.Spec.Resource.AffinityGroupLabel = map[string]string{"group": "g1"}
+				rb.Spec.Placement.WorkloadAffinity = &policyv1alpha1.WorkloadAffinityTerm{AffinityLabelKey: "group"}
+				sc.indexRB(rb)
+				sc.unindexRB(rb)
+			}
+		}()
+	}
+
+	for i := 0; i < 100; i++ {
+		snap := sc.Snapshot()
+		want := append([]string(nil), snap.GetPeerResourceBindings("default", "group", "g1", AntiAffinityRequired)...)
+		for j := 0; j < 1000; j++ {
+			if got := snap.GetPeerResourceBindings("default", "group", "g1", AntiAffinityRequired); !reflect.DeepEqual(got, want) {
+				t.Fatalf("snapshot's affinityGroups slice changed after being taken: got %v, want %v", got, want)
+			}
+		}
+	}
+
+	close(stop)
+	wg.Wait()
+}
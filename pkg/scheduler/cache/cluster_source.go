@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	clusterlister "github.com/karmada-io/karmada/pkg/generated/listers/cluster/v1alpha1"
+)
+
+// ClusterSource abstracts where the scheduler cache's view of clusters comes
+// from, so a Snapshot can be assembled from more than just Karmada's native
+// Cluster objects. Every source is expected to return its own DeepCopy-safe
+// *clusterv1alpha1.Cluster representations; Snapshot merges and de-duplicates
+// across sources by cluster name.
+type ClusterSource interface {
+	// Name identifies the source, used for logging when a source fails to list.
+	Name() string
+	// List returns every cluster currently known to this source.
+	List() ([]*clusterv1alpha1.Cluster, error)
+}
+
+// clusterListerSource adapts Karmada's native clusterLister into a ClusterSource.
+type clusterListerSource struct {
+	lister clusterlister.ClusterLister
+}
+
+// NewClusterListerSource wraps a native Karmada ClusterLister as a ClusterSource.
+func NewClusterListerSource(lister clusterlister.ClusterLister) ClusterSource {
+	return &clusterListerSource{lister: lister}
+}
+
+// Name implements ClusterSource.
+func (s *clusterListerSource) Name() string {
+	return "karmada-cluster"
+}
+
+// List implements ClusterSource.
+func (s *clusterListerSource) List() ([]*clusterv1alpha1.Cluster, error) {
+	return s.lister.List(labels.Everything())
+}
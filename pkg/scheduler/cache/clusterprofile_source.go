@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	clusterprofilev1alpha1 "sigs.k8s.io/cluster-inventory-api/apis/v1alpha1"
+	clusterprofilelister "sigs.k8s.io/cluster-inventory-api/client/listers/apis/v1alpha1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/features"
+)
+
+// clusterProfileSource adapts `multicluster.x-k8s.io` ClusterProfile objects
+// into the scheduler cache's ClusterSource contract, so third-party clusters
+// registered through the Cluster Inventory API can be scheduled against
+// alongside Karmada's own Cluster objects.
+type clusterProfileSource struct {
+	lister clusterprofilelister.ClusterProfileLister
+}
+
+// NewClusterProfileSource wraps a ClusterProfile lister as a ClusterSource.
+// It is a no-op while the ClusterInventoryAPI feature gate is disabled.
+func NewClusterProfileSource(lister clusterprofilelister.ClusterProfileLister) ClusterSource {
+	return &clusterProfileSource{lister: lister}
+}
+
+// Name implements ClusterSource.
+func (s *clusterProfileSource) Name() string {
+	return "cluster-inventory-api"
+}
+
+// List implements ClusterSource.
+func (s *clusterProfileSource) List() ([]*clusterv1alpha1.Cluster, error) {
+	if !features.FeatureGate.Enabled(features.ClusterInventoryAPI) {
+		return nil, nil
+	}
+
+	profiles, err := s.lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]*clusterv1alpha1.Cluster, 0, len(profiles))
+	for _, profile := range profiles {
+		clusters = append(clusters, clusterProfileToCluster(profile))
+	}
+	return clusters, nil
+}
+
+// clusterProfileToCluster projects a ClusterProfile into the minimal
+// clusterv1alpha1.Cluster shape the scheduler's Filter/Score plugins and
+// readiness checks rely on. Only the fields the scheduler actually reads
+// (name, labels, and a Ready condition) are populated.
+func clusterProfileToCluster(profile *clusterprofilev1alpha1.ClusterProfile) *clusterv1alpha1.Cluster {
+	cluster := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   profile.Name,
+			Labels: profile.Labels,
+		},
+	}
+
+	if condition := meta.FindStatusCondition(profile.Status.Conditions, clusterprofilev1alpha1.ClusterConditionControlPlaneHealthy); condition != nil {
+		cluster.Status.Conditions = append(cluster.Status.Conditions, metav1.Condition{
+			Type:               clusterv1alpha1.ClusterConditionReady,
+			Status:             condition.Status,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime,
+		})
+	}
+
+	return cluster
+}
@@ -28,10 +28,19 @@ import (
 type Snapshot struct {
 	// // clusterInfoList is the list of nodes as ordered in the cache's nodeTree.
 	// clusterInfoList []*framework.ClusterInfo
-	clusters     []*clusterv1alpha1.Cluster
-	clustersByRB map[string]sets.Set[string]
+	clusters []*clusterv1alpha1.Cluster
+	// clusterDomainsByRB maps rbID -> topologyKey -> the set of domain values
+	// that RB's target clusters occupy under that key. Every entry also
+	// carries a ClusterNameTopologyKey domain holding the RB's raw target
+	// cluster names, so plugins that only care about exact-cluster occupancy
+	// don't need a TopologyKey configured.
+	clusterDomainsByRB map[string]map[string]sets.Set[string]
 	// Returns a list of name/namespace RBs that match the anti-affinity group value
 	affinityGroups map[AntiKey][]string
+	// generation is the schedulerCache generation this snapshot was taken at.
+	// It lets the cache decide, on the next Snapshot() call, whether this
+	// snapshot can be reused verbatim.
+	generation int64
 }
 
 // NewEmptySnapshot initializes a Snapshot struct and returns it.
@@ -39,6 +48,11 @@ func NewEmptySnapshot() Snapshot {
 	return Snapshot{}
 }
 
+// Generation returns the cache generation this snapshot was built from.
+func (s *Snapshot) Generation() int64 {
+	return s.generation
+}
+
 // NumOfClusters returns the number of clusters.
 func (s *Snapshot) NumOfClusters() int {
 	return len(s.clusters)
@@ -60,13 +74,23 @@ func (s *Snapshot) GetReadyClusters() []*clusterv1alpha1.Cluster {
 	return ready
 }
 
-func (s *Snapshot) GetPeerResourceBindings(namespace, key, value string) []string {
-	antiKey := MakeAntiKey(namespace, key, value)
+// GetPeerResourceBindings returns the RBs indexed under the given
+// namespace/key/value for the given AffinityMode. Callers pass the mode that
+// corresponds to the term they're evaluating (e.g. AntiAffinityRequired for a
+// hard anti-affinity Filter, AffinityPreferred for a soft Affinity Score), so
+// that a single label key used by both relations never cross-contaminates.
+func (s *Snapshot) GetPeerResourceBindings(namespace, key, value string, mode AffinityMode) []string {
+	antiKey := MakeAntiKey(namespace, key, value, mode)
 	return s.affinityGroups[antiKey]
 }
 
-func (s *Snapshot) GetClustersForResourceBinding(rbID string) sets.Set[string] {
-	return s.clustersByRB[rbID]
+// GetClustersForResourceBinding returns the topology domains the given RB's
+// target clusters occupy, keyed by topology key. Look up
+// ClusterNameTopologyKey for the RB's raw target cluster names; look up a
+// configured TopologyKey (e.g. "topology.karmada.io/region") for the set of
+// label values its target clusters occupy under that key.
+func (s *Snapshot) GetClustersForResourceBinding(rbID string) map[string]sets.Set[string] {
+	return s.clusterDomainsByRB[rbID]
 }
 
 // GetReadyClusterNames returns the clusterNames in ready status.
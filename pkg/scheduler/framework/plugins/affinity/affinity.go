@@ -0,0 +1,170 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package affinity
+
+import (
+	"context"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
+
+	"github.com/karmada-io/karmada/pkg/scheduler/cache"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+)
+
+const (
+	// Name is the name of the plugin used in the plugin registry and configurations.
+	Name = "Affinity"
+
+	// maxClusterScore is the upper bound of the 0-100 range the scheduler
+	// framework normalizes every ScorePlugin's result to.
+	maxClusterScore = 100
+)
+
+// Affinity checks whether a ResourceBinding should be co-located with its
+// peers sharing the same AffinityGroupLabel, symmetric to the AntiAffinity
+// plugin. It reuses the same cache indexing as AntiAffinity, keyed by
+// AffinityMode so the two relations never collide on a shared label key.
+type Affinity struct{}
+
+var (
+	_ framework.FilterPlugin = &Affinity{}
+	_ framework.ScorePlugin  = &Affinity{}
+)
+
+// New instantiates the Affinity plugin.
+func New() (framework.Plugin, error) {
+	return &Affinity{}, nil
+}
+
+// Name returns the plugin name.
+func (p *Affinity) Name() string {
+	return Name
+}
+
+// Filter rejects a candidate cluster that has no peer ResourceBinding from
+// the same affinity group already placed there, when the Affinity term is in
+// RequiredDuringScheduling mode. A PreferredDuringScheduling term is handled
+// by Score instead.
+func (p *Affinity) Filter(
+	_ context.Context,
+	bindingSpec *workv1alpha2.ResourceBindingSpec,
+	_ *workv1alpha2.ResourceBindingStatus,
+	cluster *clusterv1alpha1.Cluster,
+	snapshot *cache.Snapshot,
+) *framework.Result {
+	if !features.FeatureGate.Enabled(features.WorkloadAffinity) {
+		return framework.NewResult(framework.Success)
+	}
+
+	term := bindingSpec.Placement.Affinity
+	if term == nil {
+		return framework.NewResult(framework.Success)
+	}
+
+	if term.Mode == policyv1alpha1.PreferredDuringScheduling {
+		return framework.NewResult(framework.Success)
+	}
+
+	if snapshot == nil {
+		return framework.NewResult(framework.Error, "affinity snapshot is nil")
+	}
+
+	groupValue := bindingSpec.Resource.AffinityGroupLabel[term.AffinityLabelKey]
+	if groupValue == "" {
+		return framework.NewResult(framework.Success)
+	}
+
+	thisID := bindingSpec.Resource.Namespace + "/" + bindingSpec.Resource.Name
+	peerRBs := snapshot.GetPeerResourceBindings(bindingSpec.Resource.Namespace, term.AffinityLabelKey, groupValue, cache.AffinityRequired)
+
+	var hasPeers bool
+	for _, peer := range peerRBs {
+		if peer == thisID {
+			continue
+		}
+		hasPeers = true
+		if snapshot.GetClustersForResourceBinding(peer)[cache.ClusterNameTopologyKey].Has(cluster.Name) {
+			return framework.NewResult(framework.Success)
+		}
+	}
+
+	if !hasPeers {
+		// No peer has been scheduled yet; nothing to co-locate with.
+		return framework.NewResult(framework.Success)
+	}
+
+	return framework.NewResult(framework.Unschedulable, "cluster has no peer placed there to satisfy this resource binding's affinity term")
+}
+
+// Score rewards a candidate cluster proportionally to the number of peer
+// ResourceBindings from the same affinity group already placed there, for an
+// Affinity term in PreferredDuringScheduling mode.
+func (p *Affinity) Score(
+	_ context.Context,
+	bindingSpec *workv1alpha2.ResourceBindingSpec,
+	_ *workv1alpha2.ResourceBindingStatus,
+	cluster *clusterv1alpha1.Cluster,
+	snapshot *cache.Snapshot,
+) (int64, *framework.Result) {
+	if !features.FeatureGate.Enabled(features.WorkloadAffinity) {
+		return 0, framework.NewResult(framework.Success)
+	}
+
+	term := bindingSpec.Placement.Affinity
+	if term == nil || term.Mode != policyv1alpha1.PreferredDuringScheduling {
+		return 0, framework.NewResult(framework.Success)
+	}
+
+	if snapshot == nil {
+		return 0, framework.NewResult(framework.Error, "affinity snapshot is nil")
+	}
+
+	groupValue := bindingSpec.Resource.AffinityGroupLabel[term.AffinityLabelKey]
+	if groupValue == "" {
+		return 0, framework.NewResult(framework.Success)
+	}
+
+	thisID := bindingSpec.Resource.Namespace + "/" + bindingSpec.Resource.Name
+	peerRBs := snapshot.GetPeerResourceBindings(bindingSpec.Resource.Namespace, term.AffinityLabelKey, groupValue, cache.AffinityPreferred)
+
+	var peersHere int64
+	for _, peer := range peerRBs {
+		if peer == thisID {
+			continue
+		}
+		if snapshot.GetClustersForResourceBinding(peer)[cache.ClusterNameTopologyKey].Has(cluster.Name) {
+			peersHere++
+		}
+	}
+	if peersHere == 0 {
+		return 0, framework.NewResult(framework.Success)
+	}
+
+	// Reward by the term's configured weight (0-100) per co-located peer,
+	// capped at maxClusterScore: a single peer at a meaningful weight already
+	// moves the score, rather than requiring enough peers for
+	// peersHere*Weight to clear 100.
+	bonus := peersHere * int64(term.Weight)
+	if bonus > maxClusterScore {
+		bonus = maxClusterScore
+	}
+
+	return bonus, framework.NewResult(framework.Success)
+}
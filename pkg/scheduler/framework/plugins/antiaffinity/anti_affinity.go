@@ -17,6 +17,7 @@ import (
 	"context"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	"github.com/karmada-io/karmada/pkg/features"
 
@@ -46,6 +47,11 @@ func (p *AntiAffinity) Name() string {
 
 // Filter checks whether scheduling this ResourceBinding to the given cluster
 // would violate its anti-affinity constraints against peer ResourceBindings.
+// Only RequiredDuringScheduling terms are enforced here; a
+// PreferredDuringScheduling term is handled by the AntiAffinity ScorePlugin
+// instead, since it should penalize rather than forbid a candidate cluster.
+// A term with a TopologyKey is the exception: it is filtered unless
+// WhenUnsatisfiable is ScheduleAnyway, whatever its Mode.
 func (p *AntiAffinity) Filter(
 	_ context.Context,
 	bindingSpec *workv1alpha2.ResourceBindingSpec,
@@ -57,7 +63,8 @@ func (p *AntiAffinity) Filter(
 		return framework.NewResult(framework.Success)
 	}
 
-	if bindingSpec.Placement.WorkloadAffinity == nil {
+	term := bindingSpec.Placement.WorkloadAffinity
+	if term == nil {
 		// WorkloadAffinity is not being used
 		return framework.NewResult(framework.Success)
 	}
@@ -66,19 +73,39 @@ func (p *AntiAffinity) Filter(
 		return framework.NewResult(framework.Error, "anti-affinity snapshot is nil")
 	}
 
-	antiAffinityGroupLabel := bindingSpec.Placement.WorkloadAffinity.AffinityLabelKey
+	antiAffinityGroupLabel := term.AffinityLabelKey
 	antiAffinityLabelValue := bindingSpec.Resource.AffinityGroupLabel[antiAffinityGroupLabel]
 	if antiAffinityLabelValue == "" {
 		return framework.NewResult(framework.Success)
 	}
 
 	thisID := bindingSpec.Resource.Namespace + "/" + bindingSpec.Resource.Name
-	peerRBs := snapshot.GetPeerResourceBindings(bindingSpec.Resource.Namespace, bindingSpec.Placement.WorkloadAffinity.AffinityLabelKey, antiAffinityLabelValue)
+	mode := cache.AntiAffinityRequired
+	if term.Mode == policyv1alpha1.PreferredDuringScheduling {
+		mode = cache.AntiAffinityPreferred
+	}
+	peerRBs := snapshot.GetPeerResourceBindings(bindingSpec.Resource.Namespace, antiAffinityGroupLabel, antiAffinityLabelValue, mode)
+
+	// A topology-scoped term is hard- or soft-enforced purely off
+	// WhenUnsatisfiable, independent of Mode: ScheduleAnyway must never be
+	// hard-filtered, even when Mode defaults to RequiredDuringScheduling.
+	if term.TopologyKey != "" {
+		if term.WhenUnsatisfiable == policyv1alpha1.ScheduleAnyway {
+			return framework.NewResult(framework.Success)
+		}
+		return filterByTopology(term, cluster, snapshot, peerRBs, thisID)
+	}
+
+	if term.Mode == policyv1alpha1.PreferredDuringScheduling {
+		// Soft term: scored, not filtered.
+		return framework.NewResult(framework.Success)
+	}
+
 	for _, peer := range peerRBs {
 		if peer == thisID {
 			continue
 		}
-		forbiddenClusters := snapshot.GetClustersForResourceBinding(peer)
+		forbiddenClusters := snapshot.GetClustersForResourceBinding(peer)[cache.ClusterNameTopologyKey]
 		if forbiddenClusters.Has(cluster.Name) {
 			return framework.NewResult(framework.Unschedulable, "cluster violates this resource bindings anti-affinity term")
 		}
@@ -86,3 +113,63 @@ func (p *AntiAffinity) Filter(
 
 	return framework.NewResult(framework.Success)
 }
+
+// filterByTopology rejects cluster if placing this ResourceBinding's peer
+// there would push the topology domain skew above term.MaxSkew, mirroring pod
+// topology spread's MaxSkew semantics: skew is max(count)-min(count) of peer
+// occupancy across every domain TopologyKey has a value for in the snapshot's
+// clusters, not just the domains a peer currently occupies, so an empty
+// domain still pulls minCount down to 0 as it should.
+func filterByTopology(
+	term *policyv1alpha1.WorkloadAffinityTerm,
+	cluster *clusterv1alpha1.Cluster,
+	snapshot *cache.Snapshot,
+	peerRBs []string,
+	thisID string,
+) *framework.Result {
+	candidateDomain := cluster.Labels[term.TopologyKey]
+	if candidateDomain == "" {
+		// Candidate doesn't participate in this topology dimension at all.
+		return framework.NewResult(framework.Success)
+	}
+
+	maxSkew := term.MaxSkew
+	if maxSkew <= 0 {
+		maxSkew = 1
+	}
+
+	// Seed every domain known to exist under TopologyKey at zero first, not
+	// just ones a peer currently occupies: an empty domain is still a valid
+	// placement target and must pull minCount down to 0, or skew is
+	// understated and placements that violate MaxSkew get admitted.
+	counts := map[string]int32{candidateDomain: 0}
+	for _, c := range snapshot.GetClusters() {
+		if domain := c.Labels[term.TopologyKey]; domain != "" {
+			if _, ok := counts[domain]; !ok {
+				counts[domain] = 0
+			}
+		}
+	}
+	for _, peer := range peerRBs {
+		if peer == thisID {
+			continue
+		}
+		for domain := range snapshot.GetClustersForResourceBinding(peer)[term.TopologyKey] {
+			counts[domain]++
+		}
+	}
+
+	minCount := counts[candidateDomain]
+	for _, count := range counts {
+		if count < minCount {
+			minCount = count
+		}
+	}
+
+	skew := counts[candidateDomain] + 1 - minCount
+	if skew > maxSkew {
+		return framework.NewResult(framework.Unschedulable, "placing this resource binding's peer here would push the topology domain skew above this anti-affinity term's MaxSkew")
+	}
+
+	return framework.NewResult(framework.Success)
+}
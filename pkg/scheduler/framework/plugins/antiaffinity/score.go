@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package antiaffinity
+
+import (
+	"context"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/features"
+
+	"github.com/karmada-io/karmada/pkg/scheduler/cache"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+)
+
+// maxClusterScore is the highest score a ScorePlugin may return for a
+// cluster; it matches the scale the scheduler framework normalizes all
+// ScorePlugin results to before weighting them.
+const maxClusterScore = 100
+
+var _ framework.ScorePlugin = &AntiAffinity{}
+
+// Score penalizes a candidate cluster proportionally to the number of peer
+// ResourceBindings from the same anti-affinity group already placed there,
+// for a WorkloadAffinity term in PreferredDuringScheduling mode, or for a
+// term with a TopologyKey whose WhenUnsatisfiable is ScheduleAnyway (then
+// counting peers in the candidate's topology domain). Other Required terms
+// are already enforced by Filter and get maxClusterScore here.
+func (p *AntiAffinity) Score(
+	_ context.Context,
+	bindingSpec *workv1alpha2.ResourceBindingSpec,
+	_ *workv1alpha2.ResourceBindingStatus,
+	cluster *clusterv1alpha1.Cluster,
+	snapshot *cache.Snapshot,
+) (int64, *framework.Result) {
+	if !features.FeatureGate.Enabled(features.WorkloadAffinity) {
+		return maxClusterScore, framework.NewResult(framework.Success)
+	}
+
+	term := bindingSpec.Placement.WorkloadAffinity
+	if term == nil {
+		return maxClusterScore, framework.NewResult(framework.Success)
+	}
+
+	// A topology-spread term in ScheduleAnyway mode is scored regardless of
+	// the group-level Mode, since Filter never hard-rejects it.
+	topologySoft := term.TopologyKey != "" && term.WhenUnsatisfiable == policyv1alpha1.ScheduleAnyway
+	if term.Mode != policyv1alpha1.PreferredDuringScheduling && !topologySoft {
+		return maxClusterScore, framework.NewResult(framework.Success)
+	}
+
+	if snapshot == nil {
+		return 0, framework.NewResult(framework.Error, "anti-affinity snapshot is nil")
+	}
+
+	groupValue := bindingSpec.Resource.AffinityGroupLabel[term.AffinityLabelKey]
+	if groupValue == "" {
+		return maxClusterScore, framework.NewResult(framework.Success)
+	}
+
+	thisID := bindingSpec.Resource.Namespace + "/" + bindingSpec.Resource.Name
+	// Peers must be looked up under the same AntiKey mode antiKeysFor indexed
+	// this RB under: Mode alone decides that, regardless of topologySoft, so
+	// a topology-soft term with the default RequiredDuringScheduling Mode is
+	// still found under AntiAffinityRequired, not AntiAffinityPreferred.
+	mode := cache.AntiAffinityRequired
+	if term.Mode == policyv1alpha1.PreferredDuringScheduling {
+		mode = cache.AntiAffinityPreferred
+	}
+	peerRBs := snapshot.GetPeerResourceBindings(bindingSpec.Resource.Namespace, term.AffinityLabelKey, groupValue, mode)
+
+	var peersHere int64
+	if topologySoft {
+		candidateDomain := cluster.Labels[term.TopologyKey]
+		if candidateDomain == "" {
+			return maxClusterScore, framework.NewResult(framework.Success)
+		}
+		for _, peer := range peerRBs {
+			if peer == thisID {
+				continue
+			}
+			if snapshot.GetClustersForResourceBinding(peer)[term.TopologyKey].Has(candidateDomain) {
+				peersHere++
+			}
+		}
+	} else {
+		for _, peer := range peerRBs {
+			if peer == thisID {
+				continue
+			}
+			if snapshot.GetClustersForResourceBinding(peer)[cache.ClusterNameTopologyKey].Has(cluster.Name) {
+				peersHere++
+			}
+		}
+	}
+	if peersHere == 0 {
+		return maxClusterScore, framework.NewResult(framework.Success)
+	}
+
+	// Penalize by the term's configured weight (0-100) per co-located peer,
+	// capped at maxClusterScore: a single peer at a meaningful weight already
+	// moves the score, rather than requiring enough peers for
+	// peersHere*Weight to clear 100.
+	penalty := peersHere * int64(term.Weight)
+	if penalty > maxClusterScore {
+		penalty = maxClusterScore
+	}
+
+	return maxClusterScore - penalty, framework.NewResult(framework.Success)
+}